@@ -0,0 +1,15 @@
+package container
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/layout"
+)
+
+// NewRowBuilderContainer returns a new container that arranges its children according to the
+// cells declared on rb, using RowBuilder's fluent Static/Dynamic/Ratio/SpaceX API as an
+// alternative to nesting HBox/VBox/Grid layouts for a single row.
+//
+// Since: 2.5
+func NewRowBuilderContainer(rb *layout.RowBuilder) *fyne.Container {
+	return fyne.NewContainerWithLayout(rb.Layout(), rb.Objects()...)
+}
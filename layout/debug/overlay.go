@@ -0,0 +1,170 @@
+// Package debug provides opt-in tooling for diagnosing layout issues without resorting to
+// fmt.Println tracing: a visual overlay of every object's bounds and position, toggled with a
+// keyboard shortcut.
+package debug
+
+import (
+	"fmt"
+	"image/color"
+	"reflect"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+)
+
+// overlayColors cycles through a small palette so nested containers remain visually
+// distinguishable from one another.
+var overlayColors = []color.Color{
+	color.NRGBA{R: 0xff, G: 0x38, B: 0x38, A: 0xff},
+	color.NRGBA{R: 0x38, G: 0x9e, B: 0xff, A: 0xff},
+	color.NRGBA{R: 0x38, G: 0xc9, B: 0x72, A: 0xff},
+	color.NRGBA{R: 0xff, G: 0xb4, B: 0x38, A: 0xff},
+}
+
+type overlay struct {
+	win     fyne.Window
+	root    *fyne.Container
+	visible bool
+}
+
+// EnableOverlay wires an F12 shortcut into win that toggles a layout inspector over its
+// content: coloured bounding rectangles and dimension labels drawn over every laid-out object,
+// plus a small popup listing the focused widget's Position, Size, MinSize and the chain of
+// parent layouts it sits inside. It recurses through *fyne.Container children; a widget's own
+// internal renderer tree is drawn as a single box. This is a development-time diagnostic and
+// should not be enabled in shipping builds.
+//
+// Since: 2.5
+func EnableOverlay(win fyne.Window) {
+	o := &overlay{win: win}
+
+	win.Canvas().AddShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyF12}, func(fyne.Shortcut) {
+		o.toggle()
+	})
+}
+
+func (o *overlay) toggle() {
+	if o.visible {
+		o.hide()
+		return
+	}
+
+	o.show()
+}
+
+func (o *overlay) show() {
+	content := o.win.Canvas().Content()
+	if content == nil {
+		return
+	}
+
+	var boxes []fyne.CanvasObject
+	walk(content, 0, fyne.NewPos(0, 0), &boxes)
+
+	if focused := o.win.Canvas().Focused(); focused != nil {
+		if obj, ok := focused.(fyne.CanvasObject); ok {
+			boxes = append(boxes, focusedInfo(obj, layoutChainOf(content, obj)))
+		}
+	}
+
+	o.root = fyne.NewContainerWithoutLayout(boxes...)
+	o.win.Canvas().Overlays().Add(o.root)
+	o.visible = true
+}
+
+func (o *overlay) hide() {
+	if o.root != nil {
+		o.win.Canvas().Overlays().Remove(o.root)
+		o.root = nil
+	}
+	o.visible = false
+}
+
+// walk draws a box for obj and recurses into its children, if any. offset is obj's ancestors'
+// accumulated position, since every CanvasObject.Position() is relative to its own parent rather
+// than the canvas - without adding it in, boxes for anything nested two or more levels deep would
+// be drawn in the wrong place.
+func walk(obj fyne.CanvasObject, depth int, offset fyne.Position, boxes *[]fyne.CanvasObject) {
+	pos := obj.Position().Add(offset)
+	size := obj.Size()
+	col := overlayColors[depth%len(overlayColors)]
+
+	box := canvas.NewRectangle(color.Transparent)
+	box.StrokeColor = col
+	box.StrokeWidth = 1
+	box.Move(pos)
+	box.Resize(size)
+	*boxes = append(*boxes, box)
+
+	label := canvas.NewText(fmt.Sprintf("%.0fx%.0f", size.Width, size.Height), col)
+	label.TextSize = 10
+	label.Move(pos)
+	*boxes = append(*boxes, label)
+
+	if cont, ok := obj.(*fyne.Container); ok {
+		for _, child := range cont.Objects {
+			walk(child, depth+1, pos, boxes)
+		}
+	}
+}
+
+func focusedInfo(obj fyne.CanvasObject, chain []string) fyne.CanvasObject {
+	text := fmt.Sprintf("focused: pos=%v size=%v min=%v", obj.Position(), obj.Size(), obj.MinSize())
+	if len(chain) > 0 {
+		text += fmt.Sprintf("\nlayouts: %s", strings.Join(chain, " > "))
+	}
+
+	info := canvas.NewText(text, color.White)
+	info.TextSize = 12
+	info.Move(fyne.NewPos(8, 8))
+
+	return info
+}
+
+// layoutChainOf walks down from root looking for target, and returns the name of each
+// *fyne.Container's Layout passed through on the way, outermost first. It returns nil if target
+// isn't found under root or none of its ancestor containers use a Layout.
+func layoutChainOf(root, target fyne.CanvasObject) []string {
+	chain, _ := collectLayoutChain(root, target, nil)
+	return chain
+}
+
+func collectLayoutChain(node, target fyne.CanvasObject, chain []string) ([]string, bool) {
+	if node == target {
+		return chain, true
+	}
+
+	cont, ok := node.(*fyne.Container)
+	if !ok {
+		return nil, false
+	}
+
+	next := chain
+	if cont.Layout != nil {
+		next = append(chain, layoutTypeName(cont.Layout))
+	}
+
+	for _, child := range cont.Objects {
+		if found, ok := collectLayoutChain(child, target, next); ok {
+			return found, true
+		}
+	}
+
+	return nil, false
+}
+
+// layoutTypeName returns a short, human-readable name for a fyne.Layout implementation, e.g.
+// "GridLayoutWithTracks" rather than the pointer value canvas.Text would otherwise print.
+func layoutTypeName(l fyne.Layout) string {
+	t := reflect.TypeOf(l)
+	if t == nil {
+		return "<nil>"
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
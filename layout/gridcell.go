@@ -0,0 +1,45 @@
+package layout
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// gridCell wraps a CanvasObject together with the span it should occupy in a
+// weightedGridLayout or GridLayoutWithTracks. Wrapping keeps the span attached to the object
+// itself - the same association GridLayoutWithTracks.spans already makes via SetCellSpan -
+// rather than tracking it in a second, package-global registry.
+type gridCell struct {
+	fyne.CanvasObject
+
+	colSpan, rowSpan int
+}
+
+// NewGridCell wraps obj so it occupies a colSpan x rowSpan rectangle of cells when added to a
+// weightedGridLayout or GridLayoutWithTracks container, instead of the layout's Cols slice
+// needing to track spans by position. Add the returned value to the container in place of obj;
+// it implements fyne.CanvasObject by delegating to obj. A child that was never wrapped with
+// NewGridCell defaults to a 1x1 span. A weightedGridLayout uses only the span along its own
+// axis (colSpan when horizontal, rowSpan when vertical), and only for children at an index
+// beyond its Cols entries.
+//
+// Since: 2.5
+func NewGridCell(obj fyne.CanvasObject, colSpan, rowSpan int) fyne.CanvasObject {
+	if colSpan < 1 {
+		colSpan = 1
+	}
+	if rowSpan < 1 {
+		rowSpan = 1
+	}
+
+	return &gridCell{CanvasObject: obj, colSpan: colSpan, rowSpan: rowSpan}
+}
+
+// gridCellSpanOf returns the span obj was given with NewGridCell, defaulting to 1x1 when obj
+// isn't a *gridCell.
+func gridCellSpanOf(obj fyne.CanvasObject) (colSpan, rowSpan int) {
+	if c, ok := obj.(*gridCell); ok {
+		return c.colSpan, c.rowSpan
+	}
+
+	return 1, 1
+}
@@ -0,0 +1,370 @@
+package layout
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// TrackSizeKind identifies how a TrackSize resolves during layout.
+//
+// Since: 2.5
+type TrackSizeKind int
+
+const (
+	// TrackFixed sizes a track to an exact pixel size, ignoring available space.
+	TrackFixed TrackSizeKind = iota
+	// TrackFr shares the remaining space proportionally to its weight, the same
+	// unit used by the original int-weighted grid columns.
+	TrackFr
+	// TrackAuto sizes a track to the largest MinSize of the items placed in it.
+	TrackAuto
+	// TrackMinMax resolves like its Max track but is clamped to never go below Min. The Max
+	// bound is applied last, so where Max resolves smaller than Min, Max wins.
+	TrackMinMax
+)
+
+// TrackSize describes how a single row or column of a GridLayoutWithTracks is sized.
+//
+// Since: 2.5
+type TrackSize struct {
+	Kind   TrackSizeKind
+	Fixed  float32
+	Weight float32
+	Min    *TrackSize
+	Max    *TrackSize
+}
+
+// FixedTrack returns a TrackSize that always resolves to the given pixel size.
+//
+// Since: 2.5
+func FixedTrack(px float32) TrackSize {
+	return TrackSize{Kind: TrackFixed, Fixed: px}
+}
+
+// FrTrack returns a TrackSize that shares the remaining space proportionally to weight,
+// matching the semantics of the original int-weighted grid columns.
+//
+// Since: 2.5
+func FrTrack(weight int) TrackSize {
+	return TrackSize{Kind: TrackFr, Weight: float32(weight)}
+}
+
+// AutoTrack returns a TrackSize that shrinks or grows to fit the MinSize of its largest item.
+//
+// Since: 2.5
+func AutoTrack() TrackSize {
+	return TrackSize{Kind: TrackAuto}
+}
+
+// MinMaxTrack returns a TrackSize that resolves like max but is never sized smaller than min,
+// unless max itself resolves smaller than min, in which case max wins.
+//
+// Since: 2.5
+func MinMaxTrack(min, max TrackSize) TrackSize {
+	return TrackSize{Kind: TrackMinMax, Min: &min, Max: &max}
+}
+
+type cellSpan struct {
+	colSpan, rowSpan int
+}
+
+type trackPlacement struct {
+	row, col, colSpan, rowSpan int
+}
+
+// GridLayoutWithTracks is a fyne.Layout that sizes its columns and rows independently
+// according to TrackSize rules, similar to a CSS grid. Children are placed left-to-right,
+// top-to-bottom and wrap onto a new row once the columns are full, unless a larger span
+// was requested for that child with SetCellSpan.
+//
+// Since: 2.5
+type GridLayoutWithTracks struct {
+	Cols, Rows []TrackSize
+
+	spans map[fyne.CanvasObject]cellSpan
+}
+
+// NewGridLayoutWithTracks returns a new grid layout using the given column and row tracks.
+// Rows may be nil, in which case rows are added automatically as Fr(1) tracks to fit however
+// many children are laid out.
+//
+// Since: 2.5
+func NewGridLayoutWithTracks(cols, rows []TrackSize) *GridLayoutWithTracks {
+	return &GridLayoutWithTracks{Cols: cols, Rows: rows, spans: make(map[fyne.CanvasObject]cellSpan)}
+}
+
+// SetCellSpan requests that child occupy a colSpan x rowSpan rectangle of cells instead of
+// the default single cell. It should be called before the layout runs for the span to apply.
+//
+// Since: 2.5
+func (g *GridLayoutWithTracks) SetCellSpan(child fyne.CanvasObject, colSpan, rowSpan int) {
+	if colSpan < 1 {
+		colSpan = 1
+	}
+	if rowSpan < 1 {
+		rowSpan = 1
+	}
+
+	g.spans[child] = cellSpan{colSpan: colSpan, rowSpan: rowSpan}
+}
+
+func (g *GridLayoutWithTracks) spanOf(child fyne.CanvasObject) cellSpan {
+	if s, ok := g.spans[child]; ok {
+		return s
+	}
+
+	colSpan, rowSpan := gridCellSpanOf(child)
+	return cellSpan{colSpan: colSpan, rowSpan: rowSpan}
+}
+
+// placeChildren walks objects in order, assigning each visible child the first free cell
+// (scanning row-major) that is large enough for its span, and returns the number of rows used.
+func (g *GridLayoutWithTracks) placeChildren(objects []fyne.CanvasObject) ([]trackPlacement, int) {
+	numCols := len(g.Cols)
+	if numCols == 0 {
+		panic("layout: GridLayoutWithTracks requires at least one column track")
+	}
+
+	placements := make([]trackPlacement, len(objects))
+	var occupied [][]bool
+	row, col := 0, 0
+
+	ensureRow := func(r int) {
+		for len(occupied) <= r {
+			occupied = append(occupied, make([]bool, numCols))
+		}
+	}
+
+	fits := func(r, c int, span cellSpan) bool {
+		for cr := r; cr < r+span.rowSpan; cr++ {
+			ensureRow(cr)
+			for cc := c; cc < c+span.colSpan; cc++ {
+				if cc >= numCols || occupied[cr][cc] {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	nextFree := func(span cellSpan) (int, int) {
+		for {
+			ensureRow(row)
+			if col >= numCols {
+				row++
+				col = 0
+				continue
+			}
+			if fits(row, col, span) {
+				return row, col
+			}
+			col++
+		}
+	}
+
+	for i, obj := range objects {
+		if !obj.Visible() {
+			placements[i] = trackPlacement{row: -1, col: -1, colSpan: 1, rowSpan: 1}
+			continue
+		}
+
+		span := g.spanOf(obj)
+		if span.colSpan > numCols {
+			span.colSpan = numCols
+		}
+
+		r, c := nextFree(span)
+		for cr := r; cr < r+span.rowSpan; cr++ {
+			ensureRow(cr)
+			for cc := c; cc < c+span.colSpan && cc < numCols; cc++ {
+				occupied[cr][cc] = true
+			}
+		}
+
+		placements[i] = trackPlacement{row: r, col: c, colSpan: span.colSpan, rowSpan: span.rowSpan}
+		col = c + span.colSpan
+	}
+
+	return placements, len(occupied)
+}
+
+// rowTracks returns the row tracks to use for a layout pass, padding out with Fr(1) tracks
+// for any row beyond those explicitly configured (or all of them, when Rows is nil).
+func (g *GridLayoutWithTracks) rowTracks(numRows int) []TrackSize {
+	tracks := make([]TrackSize, numRows)
+	for i := range tracks {
+		if i < len(g.Rows) {
+			tracks[i] = g.Rows[i]
+		} else {
+			tracks[i] = FrTrack(1)
+		}
+	}
+
+	return tracks
+}
+
+// baseSizes computes each track's contribution from children that occupy exactly one cell
+// on that axis, per the first pass of the sizing algorithm.
+func baseSizes(objects []fyne.CanvasObject, placements []trackPlacement, numCols, numRows int) (colBase, rowBase []float32) {
+	colBase = make([]float32, numCols)
+	rowBase = make([]float32, numRows)
+	for i, obj := range objects {
+		if !obj.Visible() {
+			continue
+		}
+
+		p := placements[i]
+		min := obj.MinSize()
+		if p.colSpan == 1 {
+			colBase[p.col] = fyne.Max(colBase[p.col], min.Width)
+		}
+		if p.rowSpan == 1 {
+			rowBase[p.row] = fyne.Max(rowBase[p.row], min.Height)
+		}
+	}
+
+	return colBase, rowBase
+}
+
+// resolveTrackSizes implements the second pass: each track starts from its base contribution
+// (or Fixed value) and any remaining space is distributed to Fr tracks proportionally to
+// their weight, clamping to minmax bounds.
+func resolveTrackSizes(tracks []TrackSize, base []float32, available float32) []float32 {
+	sizes := make([]float32, len(tracks))
+	var used, frWeight float32
+	for i, t := range tracks {
+		switch t.Kind {
+		case TrackFixed:
+			sizes[i] = t.Fixed
+		case TrackMinMax:
+			sizes[i] = fyne.Max(base[i], floorOf(*t.Min, base[i]))
+			if t.Max.Kind == TrackFr {
+				frWeight += t.Max.Weight
+			}
+		default: // TrackAuto, TrackFr
+			sizes[i] = base[i]
+			if t.Kind == TrackFr {
+				frWeight += t.Weight
+			}
+		}
+		used += sizes[i]
+	}
+
+	if extra := available - used; extra > 0 && frWeight > 0 {
+		each := extra / frWeight
+		for i, t := range tracks {
+			switch {
+			case t.Kind == TrackFr:
+				sizes[i] += each * t.Weight
+			case t.Kind == TrackMinMax && t.Max.Kind == TrackFr:
+				sizes[i] += each * t.Max.Weight
+			}
+		}
+	}
+
+	for i, t := range tracks {
+		if t.Kind != TrackMinMax {
+			continue
+		}
+		if ceil := ceilOf(*t.Max, base[i], available); ceil > 0 && sizes[i] > ceil {
+			sizes[i] = ceil
+		}
+	}
+
+	return sizes
+}
+
+// floorOf resolves the lower bound of a minmax track: a Fixed bound is its pixel value, an
+// Auto bound is the track's own base (content) size, and anything else (Fr, whose pixel size
+// is only known after distribution) has no meaningful floor.
+func floorOf(t TrackSize, base float32) float32 {
+	switch t.Kind {
+	case TrackFixed:
+		return t.Fixed
+	case TrackAuto:
+		return base
+	default:
+		return 0
+	}
+}
+
+// ceilOf resolves the upper bound of a minmax track the same way floorOf resolves the lower
+// bound, falling back to the full available space when the bound can't be pinned to a pixel
+// size (Fr).
+func ceilOf(t TrackSize, base, available float32) float32 {
+	switch t.Kind {
+	case TrackFixed:
+		return t.Fixed
+	case TrackAuto:
+		return base
+	default:
+		return available
+	}
+}
+
+func trackPositions(sizes []float32, pad float32) []float32 {
+	pos := make([]float32, len(sizes))
+	var at float32
+	for i, s := range sizes {
+		pos[i] = at
+		at += s + pad
+	}
+
+	return pos
+}
+
+func sumSizes(sizes []float32) float32 {
+	var total float32
+	for _, s := range sizes {
+		total += s
+	}
+
+	return total
+}
+
+// Layout is called to pack all child objects into a specified size, resolving each column
+// and row track according to its TrackSize rule.
+func (g *GridLayoutWithTracks) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	placements, numRows := g.placeChildren(objects)
+	rows := g.rowTracks(numRows)
+	pad := theme.Padding()
+
+	colBase, rowBase := baseSizes(objects, placements, len(g.Cols), numRows)
+	colPad := pad * fyne.Max(float32(len(g.Cols)-1), 0)
+	rowPad := pad * fyne.Max(float32(numRows-1), 0)
+	colSizes := resolveTrackSizes(g.Cols, colBase, size.Width-colPad)
+	rowSizes := resolveTrackSizes(rows, rowBase, size.Height-rowPad)
+
+	colPos := trackPositions(colSizes, pad)
+	rowPos := trackPositions(rowSizes, pad)
+
+	for i, obj := range objects {
+		if !obj.Visible() {
+			continue
+		}
+
+		p := placements[i]
+		x1, y1 := colPos[p.col], rowPos[p.row]
+		x2 := colPos[p.col+p.colSpan-1] + colSizes[p.col+p.colSpan-1]
+		y2 := rowPos[p.row+p.rowSpan-1] + rowSizes[p.row+p.rowSpan-1]
+
+		obj.Move(fyne.NewPos(x1, y1))
+		obj.Resize(fyne.NewSize(x2-x1, y2-y1))
+	}
+}
+
+// MinSize finds the smallest size that satisfies all the child objects, summing each track's
+// base size (the size it would resolve to with no extra space to distribute).
+func (g *GridLayoutWithTracks) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	placements, numRows := g.placeChildren(objects)
+	rows := g.rowTracks(numRows)
+
+	colBase, rowBase := baseSizes(objects, placements, len(g.Cols), numRows)
+	colSizes := resolveTrackSizes(g.Cols, colBase, 0)
+	rowSizes := resolveTrackSizes(rows, rowBase, 0)
+
+	pad := theme.Padding()
+	colPad := pad * fyne.Max(float32(len(g.Cols)-1), 0)
+	rowPad := pad * fyne.Max(float32(numRows-1), 0)
+	return fyne.NewSize(sumSizes(colSizes)+colPad, sumSizes(rowSizes)+rowPad)
+}
@@ -0,0 +1,101 @@
+package layout
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubCanvasObject struct {
+	pos     fyne.Position
+	size    fyne.Size
+	minSize fyne.Size
+	hidden  bool
+}
+
+func (s *stubCanvasObject) MinSize() fyne.Size      { return s.minSize }
+func (s *stubCanvasObject) Move(pos fyne.Position)  { s.pos = pos }
+func (s *stubCanvasObject) Position() fyne.Position { return s.pos }
+func (s *stubCanvasObject) Resize(size fyne.Size)   { s.size = size }
+func (s *stubCanvasObject) Size() fyne.Size         { return s.size }
+func (s *stubCanvasObject) Hide()                   { s.hidden = true }
+func (s *stubCanvasObject) Show()                   { s.hidden = false }
+func (s *stubCanvasObject) Visible() bool           { return !s.hidden }
+func (s *stubCanvasObject) Refresh()                {}
+
+func newStub(w, h float32) *stubCanvasObject {
+	return &stubCanvasObject{minSize: fyne.NewSize(w, h)}
+}
+
+func TestResolveTrackSizes(t *testing.T) {
+	cases := []struct {
+		name      string
+		tracks    []TrackSize
+		base      []float32
+		available float32
+		want      []float32
+	}{
+		{
+			name:      "fixed tracks ignore available space",
+			tracks:    []TrackSize{FixedTrack(50), FixedTrack(30)},
+			base:      []float32{0, 0},
+			available: 100,
+			want:      []float32{50, 30},
+		},
+		{
+			name:      "fr tracks share remaining space by weight",
+			tracks:    []TrackSize{FrTrack(1), FrTrack(3)},
+			base:      []float32{10, 10},
+			available: 100,
+			want:      []float32{30, 70},
+		},
+		{
+			name:      "minmax clamps to a fixed ceiling",
+			tracks:    []TrackSize{MinMaxTrack(FixedTrack(0), FixedTrack(80))},
+			base:      []float32{150},
+			available: 1000,
+			want:      []float32{80},
+		},
+		{
+			name:      "minmax floors to a fixed minimum",
+			tracks:    []TrackSize{MinMaxTrack(FixedTrack(80), FixedTrack(200))},
+			base:      []float32{10},
+			available: 1000,
+			want:      []float32{80},
+		},
+		{
+			name:      "minmax clamps to an auto ceiling, not unbounded",
+			tracks:    []TrackSize{MinMaxTrack(FixedTrack(200), AutoTrack())},
+			base:      []float32{50},
+			available: 1000,
+			want:      []float32{50},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := resolveTrackSizes(c.tracks, c.base, c.available)
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestGridLayoutWithTracksNoColumnsPanics(t *testing.T) {
+	g := NewGridLayoutWithTracks(nil, nil)
+
+	assert.Panics(t, func() {
+		g.Layout([]fyne.CanvasObject{newStub(10, 10)}, fyne.NewSize(100, 100))
+	})
+}
+
+func TestGridLayoutWithTracksMinSize(t *testing.T) {
+	// A single column/row adds no padding between tracks, so the result doesn't depend on
+	// theme.Padding(), which this test can't control.
+	g := NewGridLayoutWithTracks([]TrackSize{FixedTrack(100)}, nil)
+	objects := []fyne.CanvasObject{newStub(20, 10)}
+
+	min := g.MinSize(objects)
+	assert.Equal(t, float32(100), min.Width)
+	assert.Equal(t, float32(10), min.Height)
+}
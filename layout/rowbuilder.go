@@ -0,0 +1,211 @@
+package layout
+
+import "fyne.io/fyne/v2"
+
+type rowCellKind int
+
+const (
+	rowCellStatic rowCellKind = iota
+	rowCellDynamic
+	rowCellRatio
+	rowCellSpace
+)
+
+type rowCell struct {
+	kind   rowCellKind
+	size   float32 // static cell width, or spacer width
+	ratio  float32
+	object fyne.CanvasObject
+}
+
+// RowBuilder declaratively assembles a single horizontal row, partitioning its width into
+// fixed pixel spans, ratio-based spans and dynamic (equal-share) spans. It is a fluent
+// alternative to nesting HBox/VBox/Grid layouts for one-off toolbars, form rows and status
+// bars where each cell needs its own sizing rule.
+//
+// Since: 2.5
+type RowBuilder struct {
+	cells []rowCell
+}
+
+// NewRowBuilder returns an empty RowBuilder ready to have cells added to it.
+//
+// Since: 2.5
+func NewRowBuilder() *RowBuilder {
+	return &RowBuilder{}
+}
+
+// Static adds a cell with a fixed pixel width.
+//
+// Since: 2.5
+func (rb *RowBuilder) Static(width float32, obj fyne.CanvasObject) *RowBuilder {
+	rb.cells = append(rb.cells, rowCell{kind: rowCellStatic, size: width, object: obj})
+	return rb
+}
+
+// Dynamic adds a cell that shares the row's remaining width equally with the other dynamic cells.
+//
+// Since: 2.5
+func (rb *RowBuilder) Dynamic(obj fyne.CanvasObject) *RowBuilder {
+	rb.cells = append(rb.cells, rowCell{kind: rowCellDynamic, object: obj})
+	return rb
+}
+
+// Ratio adds a cell that takes the given fraction (0-1) of the row's remaining width, after
+// static and spacer widths have been subtracted.
+//
+// Since: 2.5
+func (rb *RowBuilder) Ratio(ratio float32, obj fyne.CanvasObject) *RowBuilder {
+	rb.cells = append(rb.cells, rowCell{kind: rowCellRatio, ratio: ratio, object: obj})
+	return rb
+}
+
+// SpaceX inserts a fixed-width gap of n pixels at this point in the row.
+//
+// Since: 2.5
+func (rb *RowBuilder) SpaceX(n float32) *RowBuilder {
+	rb.cells = append(rb.cells, rowCell{kind: rowCellSpace, size: n})
+	return rb
+}
+
+// Objects returns the widgets that have been added to the row, in order, for use by a
+// container built from this RowBuilder.
+//
+// Since: 2.5
+func (rb *RowBuilder) Objects() []fyne.CanvasObject {
+	objects := make([]fyne.CanvasObject, 0, len(rb.cells))
+	for _, c := range rb.cells {
+		if c.object != nil {
+			objects = append(objects, c.object)
+		}
+	}
+
+	return objects
+}
+
+// Layout builds the fyne.Layout that arranges this row's cells according to the rules they
+// were added with.
+//
+// Since: 2.5
+func (rb *RowBuilder) Layout() fyne.Layout {
+	return &rowBuilderLayout{cells: rb.cells}
+}
+
+// Declare conformity with Layout interface
+var _ fyne.Layout = (*rowBuilderLayout)(nil)
+
+type rowBuilderLayout struct {
+	cells []rowCell
+}
+
+func (r *rowBuilderLayout) staticWidth() float32 {
+	var total float32
+	for _, c := range r.cells {
+		if c.kind == rowCellStatic {
+			total += c.size
+		}
+	}
+
+	return total
+}
+
+func (r *rowBuilderLayout) spaceWidth() float32 {
+	var total float32
+	for _, c := range r.cells {
+		if c.kind == rowCellSpace {
+			total += c.size
+		}
+	}
+
+	return total
+}
+
+func (r *rowBuilderLayout) ratioSum() float32 {
+	var total float32
+	for _, c := range r.cells {
+		if c.kind == rowCellRatio {
+			total += c.ratio
+		}
+	}
+
+	return total
+}
+
+func (r *rowBuilderLayout) dynamicCount() int {
+	count := 0
+	for _, c := range r.cells {
+		if c.kind == rowCellDynamic {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Layout is called to pack the row's cells into a specified size, honouring each cell's
+// static, ratio or dynamic sizing rule in turn.
+func (r *rowBuilderLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	remaining := size.Width - r.staticWidth() - r.spaceWidth()
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	dynamicWidth := float32(0)
+	if count := r.dynamicCount(); count > 0 {
+		dynamicWidth = (remaining - remaining*r.ratioSum()) / float32(count)
+		if dynamicWidth < 0 {
+			dynamicWidth = 0
+		}
+	}
+
+	var x float32
+	i := 0
+	for _, c := range r.cells {
+		if c.kind == rowCellSpace {
+			x += c.size
+			continue
+		}
+
+		obj := objects[i]
+		i++
+
+		var width float32
+		switch c.kind {
+		case rowCellStatic:
+			width = c.size
+		case rowCellRatio:
+			width = remaining * c.ratio
+		case rowCellDynamic:
+			width = dynamicWidth
+		}
+
+		obj.Move(fyne.NewPos(x, 0))
+		obj.Resize(fyne.NewSize(width, size.Height))
+		x += width
+	}
+}
+
+// MinSize finds the smallest size that satisfies all the row's cells: the sum of static and
+// spacer widths plus the MinSize width of each ratio/dynamic cell, and the tallest child.
+func (r *rowBuilderLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	width := r.staticWidth() + r.spaceWidth()
+	var height float32
+
+	i := 0
+	for _, c := range r.cells {
+		if c.kind == rowCellSpace {
+			continue
+		}
+
+		obj := objects[i]
+		i++
+		min := obj.MinSize()
+		height = fyne.Max(height, min.Height)
+
+		if c.kind != rowCellStatic {
+			width += min.Width
+		}
+	}
+
+	return fyne.NewSize(width, height)
+}
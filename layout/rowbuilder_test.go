@@ -0,0 +1,62 @@
+package layout
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRowBuilderLayoutStatic(t *testing.T) {
+	obj1, obj2 := newStub(10, 10), newStub(10, 20)
+	rb := NewRowBuilder().Static(50, obj1).Static(30, obj2)
+	l := rb.Layout()
+
+	l.Layout(rb.Objects(), fyne.NewSize(200, 40))
+
+	assert.Equal(t, fyne.NewPos(0, 0), obj1.Position())
+	assert.Equal(t, float32(50), obj1.Size().Width)
+	assert.Equal(t, float32(50), obj2.Position().X)
+	assert.Equal(t, float32(30), obj2.Size().Width)
+
+	min := l.MinSize(rb.Objects())
+	assert.Equal(t, float32(80), min.Width)
+	assert.Equal(t, float32(20), min.Height)
+}
+
+func TestRowBuilderLayoutDynamicSharesRemainingSpace(t *testing.T) {
+	static, dyn1, dyn2 := newStub(10, 10), newStub(0, 0), newStub(0, 0)
+	rb := NewRowBuilder().Static(50, static).Dynamic(dyn1).Dynamic(dyn2)
+	l := rb.Layout()
+
+	l.Layout(rb.Objects(), fyne.NewSize(250, 40))
+
+	assert.Equal(t, float32(50), dyn1.Position().X)
+	assert.Equal(t, float32(100), dyn1.Size().Width)
+	assert.Equal(t, float32(150), dyn2.Position().X)
+	assert.Equal(t, float32(100), dyn2.Size().Width)
+}
+
+func TestRowBuilderLayoutRatio(t *testing.T) {
+	obj1, obj2 := newStub(0, 0), newStub(0, 0)
+	rb := NewRowBuilder().Ratio(0.3, obj1).Ratio(0.7, obj2)
+	l := rb.Layout()
+
+	l.Layout(rb.Objects(), fyne.NewSize(100, 40))
+
+	// 0.3 has no exact float32 form, so 100*0.3 comes out as 30.000002.
+	assert.InDelta(t, float32(30), obj1.Size().Width, 0.0001)
+	assert.InDelta(t, float32(30), obj2.Position().X, 0.0001)
+	assert.Equal(t, float32(70), obj2.Size().Width)
+}
+
+func TestRowBuilderLayoutSpaceX(t *testing.T) {
+	static, dyn := newStub(0, 0), newStub(0, 0)
+	rb := NewRowBuilder().Static(10, static).SpaceX(5).Dynamic(dyn)
+	l := rb.Layout()
+
+	l.Layout(rb.Objects(), fyne.NewSize(100, 40))
+
+	assert.Equal(t, float32(15), dyn.Position().X)
+	assert.Equal(t, float32(85), dyn.Size().Width)
+}
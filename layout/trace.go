@@ -0,0 +1,85 @@
+package layout
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// TraceEntry records a single Layout or MinSize call captured by Trace.
+//
+// Since: 2.5
+type TraceEntry struct {
+	Layout   string
+	Call     string // "Layout" or "MinSize"
+	Duration time.Duration
+	At       time.Time
+}
+
+const traceRingSize = 256
+
+var (
+	traceMu  sync.Mutex
+	traceBuf [traceRingSize]TraceEntry
+	tracePos int
+	traceLen int
+)
+
+func recordTrace(e TraceEntry) {
+	traceMu.Lock()
+	defer traceMu.Unlock()
+
+	traceBuf[tracePos] = e
+	tracePos = (tracePos + 1) % traceRingSize
+	if traceLen < traceRingSize {
+		traceLen++
+	}
+}
+
+// TraceEntries returns a copy of the entries recorded by Trace-wrapped layouts so far, oldest
+// first. It holds at most the last 256 calls.
+//
+// Since: 2.5
+func TraceEntries() []TraceEntry {
+	traceMu.Lock()
+	defer traceMu.Unlock()
+
+	out := make([]TraceEntry, traceLen)
+	for i := 0; i < traceLen; i++ {
+		out[i] = traceBuf[(tracePos-traceLen+i+traceRingSize)%traceRingSize]
+	}
+
+	return out
+}
+
+// Declare conformity with Layout interface
+var _ fyne.Layout = (*tracedLayout)(nil)
+
+type tracedLayout struct {
+	wrapped fyne.Layout
+	name    string
+}
+
+// Trace wraps l so every Layout and MinSize call made on it is timed and recorded to an
+// in-memory ring buffer, retrievable with TraceEntries. It is an opt-in diagnostic for chasing
+// responsive-grid issues and is not meant to stay wrapped around a layout in production code.
+//
+// Since: 2.5
+func Trace(l fyne.Layout) fyne.Layout {
+	return &tracedLayout{wrapped: l, name: fmt.Sprintf("%T", l)}
+}
+
+func (t *tracedLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	start := time.Now()
+	t.wrapped.Layout(objects, size)
+	recordTrace(TraceEntry{Layout: t.name, Call: "Layout", Duration: time.Since(start), At: start})
+}
+
+func (t *tracedLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	start := time.Now()
+	size := t.wrapped.MinSize(objects)
+	recordTrace(TraceEntry{Layout: t.name, Call: "MinSize", Duration: time.Since(start), At: start})
+	return size
+}
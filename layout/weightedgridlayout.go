@@ -1,7 +1,6 @@
 package layout
 
 import (
-	"fmt"
 	"math"
 
 	"fyne.io/fyne/v2"
@@ -12,6 +11,9 @@ import (
 var _ fyne.Layout = (*weightedGridLayout)(nil)
 
 type weightedGridLayout struct {
+	// Deprecated: Cols must be kept in lockstep with the container's children slice, which
+	// breaks when children are added, removed or hidden dynamically. Use NewGridCell to attach
+	// a span to a child instead; spanAt falls back to it for any index beyond this slice.
 	Cols            []int
 	TotalCols       int
 	vertical, adapt bool
@@ -37,8 +39,18 @@ func NewWeightedGridLayout(cols []int) fyne.Layout {
 }
 
 // NewWeightedGridLayoutWithColumns returns a new grid layout that specifies a column count and wrap to new rows when needed.
+//
+// Internally this builds a GridLayoutWithTracks with one Fr track per column weight and rows
+// that grow automatically to fit the children. Column widths keep the proportions of their
+// weights, but padding is only added between columns, and MinSize is the sum of each column's
+// widest child rather than the widest child multiplied by the total weight.
 func NewWeightedGridLayoutWithColumns(cols []int) fyne.Layout {
-	return &weightedGridLayout{Cols: cols, TotalCols: sum(cols)}
+	tracks := make([]TrackSize, len(cols))
+	for i, weight := range cols {
+		tracks[i] = FrTrack(weight)
+	}
+
+	return NewGridLayoutWithTracks(tracks, nil)
 }
 
 // NewWeightedGridLayoutWithRows returns a new grid layout that specifies a row count that creates new rows as required.
@@ -54,11 +66,28 @@ func (g *weightedGridLayout) horizontal() bool {
 	return !g.vertical
 }
 
+// spanAt returns the weight/span for the child at idx: the corresponding Cols entry if one was
+// configured for that index, otherwise whatever span was attached to the child with
+// NewGridCell (defaulting to 1 if it was never wrapped). This lets children be added, removed
+// or reordered without having to keep Cols in lockstep.
+func (g *weightedGridLayout) spanAt(idx int, child fyne.CanvasObject) int {
+	if idx < len(g.Cols) {
+		return g.Cols[idx]
+	}
+
+	colSpan, rowSpan := gridCellSpanOf(child)
+	if g.horizontal() {
+		return colSpan
+	}
+
+	return rowSpan
+}
+
 func (g *weightedGridLayout) countRows(objects []fyne.CanvasObject) int {
 	count := 0
 	for i, child := range objects {
 		if child.Visible() {
-			count += g.Cols[i]
+			count += g.spanAt(i, child)
 		}
 	}
 
@@ -70,7 +99,6 @@ func (g *weightedGridLayout) countRows(objects []fyne.CanvasObject) int {
 // of columns specified in our constructor.
 func (g *weightedGridLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 	rows := g.countRows(objects)
-	fmt.Println("SIZE", size)
 	padWidth := float32(g.TotalCols-1) * theme.Padding()
 	padHeight := float32(rows-1) * theme.Padding()
 	cellWidth := float64(size.Width-padWidth) / float64(g.TotalCols)
@@ -89,7 +117,7 @@ func (g *weightedGridLayout) Layout(objects []fyne.CanvasObject, size fyne.Size)
 			continue
 		}
 
-		span := g.Cols[idx]
+		span := g.spanAt(idx, child)
 		colSpan, rowSpan := 1, 1
 		if g.horizontal() {
 			colSpan = span
@@ -100,7 +128,6 @@ func (g *weightedGridLayout) Layout(objects []fyne.CanvasObject, size fyne.Size)
 		y1 := getLeading(cellHeight, row)
 		x2 := getTrailing(cellWidth, col+colSpan-1)
 		y2 := getTrailing(cellHeight, row+rowSpan-1)
-		fmt.Println(x1, y1, "-", x2, y2)
 		child.Move(fyne.NewPos(x1, y1))
 		child.Resize(fyne.NewSize(x2-x1, y2-y1))
 
@@ -138,7 +165,7 @@ func (g *weightedGridLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
 			continue
 		}
 		childMinSize := child.MinSize()
-		childMinSize.Height /= float32(g.Cols[i])
+		childMinSize.Height /= float32(g.spanAt(i, child))
 		minSize = minSize.Max(childMinSize)
 	}
 	if g.horizontal() {
@@ -0,0 +1,83 @@
+package widget
+
+import (
+	"image/color"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/widget"
+)
+
+// ButtonStyle groups all of the visual properties of a CMButton so that they can be swapped
+// as a unit: attached to a single button with SetStyle, shared by a subtree of buttons via a
+// StyleProvider, or registered under a name with RegisterButtonStyle and applied with UseStyle.
+//
+// Since: 2.5
+type ButtonStyle struct {
+	ColorEnabled, ColorDisabled, ColorFocused, ColorPrimary, ColorHover color.Color
+
+	Padding        fyne.Size
+	CornerRadius   float32
+	ShadowLevel    widget.ElevationLevel
+	IconSize       float32
+	TextStyle      RichTextStyle
+	AnimationCurve fyne.AnimationCurve
+	AnimationTime  time.Duration
+}
+
+// StyleProvider is implemented by a container, or anything else placed above a CMButton in the
+// widget tree, that wants to supply a ButtonStyle to every button created within its scope,
+// without each button needing SetStyle called on it individually.
+//
+// Since: 2.5
+type StyleProvider interface {
+	ButtonStyle() ButtonStyle
+}
+
+// findStyleProvider searches node for target, remembering the nearest StyleProvider seen on
+// the way down so it can be returned once target is found. It only recurses into
+// *fyne.Container children - a widget's own internal renderer tree is opaque to it, the same
+// limitation as the layout/debug overlay.
+func findStyleProvider(node, target fyne.CanvasObject, nearest StyleProvider) (StyleProvider, bool) {
+	if node == target {
+		return nearest, nearest != nil
+	}
+
+	if p, ok := node.(StyleProvider); ok {
+		nearest = p
+	}
+
+	if cont, ok := node.(*fyne.Container); ok {
+		for _, child := range cont.Objects {
+			if found, ok := findStyleProvider(child, target, nearest); ok {
+				return found, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+var (
+	buttonStylesMu sync.RWMutex
+	buttonStyles   = map[string]ButtonStyle{}
+)
+
+// RegisterButtonStyle registers a named ButtonStyle variant, for example "danger", "toolbar"
+// or "link", that any CMButton can later switch to at runtime with UseStyle, without needing
+// to walk the tree replacing widgets.
+//
+// Since: 2.5
+func RegisterButtonStyle(name string, s ButtonStyle) {
+	buttonStylesMu.Lock()
+	defer buttonStylesMu.Unlock()
+	buttonStyles[name] = s
+}
+
+func lookupButtonStyle(name string) (ButtonStyle, bool) {
+	buttonStylesMu.RLock()
+	defer buttonStylesMu.RUnlock()
+	s, ok := buttonStyles[name]
+	return s, ok
+}
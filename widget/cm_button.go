@@ -24,28 +24,43 @@ type CMButton struct {
 	Importance    ButtonImportance
 	Alignment     ButtonAlign
 	IconPlacement ButtonIconPlacement
-	ColorEnabled  color.Color
+
+	// Style overrides every visual property of this button alone - colors, padding, corner
+	// radius, shadow level, icon size and tap animation. It takes precedence over a named
+	// style applied with UseStyle and over any StyleProvider found above this button in the
+	// canvas tree. The shadow level is read once, when the button's renderer is created; a
+	// style applied after that does not change it.
+	//
+	// Since: 2.5
+	Style *ButtonStyle
+
+	// Deprecated: Use Style.ColorEnabled, or RegisterButtonStyle/UseStyle for a named variant.
+	ColorEnabled color.Color
+	// Deprecated: Use Style.ColorDisabled, or RegisterButtonStyle/UseStyle for a named variant.
 	ColorDisabled color.Color
-	ColorFocused  color.Color
-	ColorPrimary  color.Color
-	ColorHover    color.Color
+	// Deprecated: Use Style.ColorFocused, or RegisterButtonStyle/UseStyle for a named variant.
+	ColorFocused color.Color
+	// Deprecated: Use Style.ColorPrimary, or RegisterButtonStyle/UseStyle for a named variant.
+	ColorPrimary color.Color
+	// Deprecated: Use Style.ColorHover, or RegisterButtonStyle/UseStyle for a named variant.
+	ColorHover color.Color
 
 	OnTapped func() `json:"-"`
 
 	hovered, focused bool
 	tapAnim          *fyne.Animation
+	styleName        string
+
+	styleProviderCanvas fyne.Canvas
+	styleProvider       StyleProvider
+	styleProviderFound  bool
 }
 
 // NewCMButton creates a new button widget with the set label and tap handler
 func NewCMButton(label string, tapped func()) *CMButton {
 	button := &CMButton{
-		Text:          label,
-		OnTapped:      tapped,
-		ColorEnabled:  theme.ButtonColor(),
-		ColorDisabled: theme.DisabledButtonColor(),
-		ColorFocused:  theme.FocusColor(),
-		ColorPrimary:  theme.PrimaryColor(),
-		ColorHover:    theme.HoverColor(),
+		Text:     label,
+		OnTapped: tapped,
 	}
 
 	button.ExtendBaseWidget(button)
@@ -64,34 +79,144 @@ func NewCMButtonWithIcon(label string, icon fyne.Resource, tapped func()) *CMBut
 	return button
 }
 
+// SetStyle attaches a ButtonStyle to this button alone, overriding the theme, any named style
+// applied with UseStyle and any StyleProvider found above this button in the canvas tree.
+//
+// Since: 2.5
+func (b *CMButton) SetStyle(s ButtonStyle) {
+	b.Style = &s
+	b.Refresh()
+}
+
+// UseStyle switches this button to a named style previously registered with
+// RegisterButtonStyle. An unregistered name falls back to an ancestor StyleProvider or the theme.
+//
+// Since: 2.5
+func (b *CMButton) UseStyle(name string) {
+	b.styleName = name
+	b.Style = nil
+	b.Refresh()
+}
+
+// resolveStyle returns the ButtonStyle that currently applies to this button, checking an
+// explicit Style, then a named style, then the nearest StyleProvider above this button in the
+// canvas tree, and finally falling back to the current theme.
+func (b *CMButton) resolveStyle() ButtonStyle {
+	if b.Style != nil {
+		return *b.Style
+	}
+	if b.styleName != "" {
+		if s, ok := lookupButtonStyle(b.styleName); ok {
+			return s
+		}
+	}
+	if provider, ok := b.ancestorStyleProvider(); ok {
+		return provider.ButtonStyle()
+	}
+
+	return b.themeStyle()
+}
+
+// ancestorStyleProvider returns the nearest StyleProvider above this button in the canvas tree,
+// the same way findStyleProvider does, but remembers the result for as long as the button stays
+// on the same canvas. Without this, resolveStyle - called on every Refresh, i.e. every hover,
+// focus and disable toggle - would re-walk the whole canvas content tree on every frame just to
+// learn that the common, unstyled button has no provider above it.
+func (b *CMButton) ancestorStyleProvider() (StyleProvider, bool) {
+	obj := b.super()
+
+	app := fyne.CurrentApp()
+	if app == nil {
+		return nil, false
+	}
+
+	c := app.Driver().CanvasForObject(obj)
+	if c == nil || c.Content() == nil {
+		return nil, false
+	}
+
+	if c == b.styleProviderCanvas {
+		return b.styleProvider, b.styleProviderFound
+	}
+
+	provider, ok := findStyleProvider(c.Content(), obj, nil)
+	b.styleProviderCanvas = c
+	b.styleProvider = provider
+	b.styleProviderFound = ok
+	return provider, ok
+}
+
+// themeStyle builds the default ButtonStyle from the current theme, honouring any of the
+// deprecated per-field color overrides that were explicitly set.
+func (b *CMButton) themeStyle() ButtonStyle {
+	s := ButtonStyle{
+		ColorEnabled:   theme.ButtonColor(),
+		ColorDisabled:  theme.DisabledButtonColor(),
+		ColorFocused:   theme.FocusColor(),
+		ColorPrimary:   theme.PrimaryColor(),
+		ColorHover:     theme.HoverColor(),
+		Padding:        fyne.NewSize(theme.Padding()*6, theme.Padding()*4),
+		ShadowLevel:    widget.ButtonLevel,
+		IconSize:       theme.IconInlineSize(),
+		TextStyle:      RichTextStyleStrong,
+		AnimationCurve: fyne.AnimationEaseOut,
+		AnimationTime:  canvas.DurationStandard,
+	}
+	if b.Text == "" {
+		s.Padding = fyne.NewSize(theme.Padding()*4, theme.Padding()*4)
+	}
+	if b.Importance == LowImportance {
+		s.ShadowLevel = widget.BaseLevel
+	}
+
+	if b.ColorEnabled != nil {
+		s.ColorEnabled = b.ColorEnabled
+	}
+	if b.ColorDisabled != nil {
+		s.ColorDisabled = b.ColorDisabled
+	}
+	if b.ColorFocused != nil {
+		s.ColorFocused = b.ColorFocused
+	}
+	if b.ColorPrimary != nil {
+		s.ColorPrimary = b.ColorPrimary
+	}
+	if b.ColorHover != nil {
+		s.ColorHover = b.ColorHover
+	}
+
+	return s
+}
+
 // CreateRenderer is a private method to Fyne which links this widget to its renderer
 func (b *CMButton) CreateRenderer() fyne.WidgetRenderer {
 	b.ExtendBaseWidget(b)
-	seg := &TextSegment{Text: b.Text, Style: RichTextStyleStrong}
+	style := b.resolveStyle()
+
+	seg := &TextSegment{Text: b.Text, Style: style.TextStyle}
 	seg.Style.Alignment = fyne.TextAlignCenter
 	text := NewRichText(seg)
 	text.inset = fyne.NewSize(theme.Padding()*2, theme.Padding()*2)
 
-	background := canvas.NewRectangle(b.ColorEnabled)
+	background := canvas.NewRectangle(style.ColorEnabled)
+	background.CornerRadius = style.CornerRadius
 	tapBG := canvas.NewRectangle(color.Transparent)
 	b.tapAnim = newButtonTapAnimation(tapBG, b)
-	b.tapAnim.Curve = fyne.AnimationEaseOut
+	b.tapAnim.Curve = style.AnimationCurve
+	b.tapAnim.Duration = style.AnimationTime
 	objects := []fyne.CanvasObject{
 		background,
 		tapBG,
 		text,
 	}
-	shadowLevel := widget.ButtonLevel
-	if b.Importance == LowImportance {
-		shadowLevel = widget.BaseLevel
-	}
 	r := &cmButtonRenderer{
-		ShadowingRenderer: widget.NewShadowingRenderer(objects, shadowLevel),
+		ShadowingRenderer: widget.NewShadowingRenderer(objects, style.ShadowLevel),
 		background:        background,
 		tapBG:             tapBG,
 		cmButton:          b,
 		label:             text,
 		layout:            layout.NewHBoxLayout(),
+		style:             style,
 	}
 	r.updateIconAndText()
 	r.applyTheme()
@@ -193,6 +318,7 @@ type cmButtonRenderer struct {
 	tapBG      *canvas.Rectangle
 	cmButton   *CMButton
 	layout     fyne.Layout
+	style      ButtonStyle
 }
 
 // Layout the components of the button widget
@@ -214,9 +340,9 @@ func (r *cmButtonRenderer) Layout(size fyne.Size) {
 		// Nothing to layout
 		return
 	}
-	iconSize := fyne.NewSize(theme.IconInlineSize(), theme.IconInlineSize())
+	iconSize := fyne.NewSize(r.style.IconSize, r.style.IconSize)
 	labelSize := r.label.MinSize()
-	padding := r.padding()
+	padding := r.style.Padding
 	if hasLabel {
 		if hasIcon {
 			// Both
@@ -250,7 +376,7 @@ func (r *cmButtonRenderer) Layout(size fyne.Size) {
 func (r *cmButtonRenderer) MinSize() (size fyne.Size) {
 	hasIcon := r.icon != nil
 	hasLabel := r.label.Segments[0].(*TextSegment).Text != ""
-	iconSize := fyne.NewSize(theme.IconInlineSize(), theme.IconInlineSize())
+	iconSize := fyne.NewSize(r.style.IconSize, r.style.IconSize)
 	labelSize := r.label.MinSize()
 	if hasLabel {
 		size.Width = labelSize.Width
@@ -262,13 +388,21 @@ func (r *cmButtonRenderer) MinSize() (size fyne.Size) {
 		size.Width += iconSize.Width
 	}
 	size.Height = fyne.Max(labelSize.Height, iconSize.Height)
-	size = size.Add(r.padding())
+	size = size.Add(r.style.Padding)
 	return
 }
 
 func (r *cmButtonRenderer) Refresh() {
+	r.style = r.cmButton.resolveStyle()
+	if r.cmButton.tapAnim != nil {
+		r.cmButton.tapAnim.Curve = r.style.AnimationCurve
+		r.cmButton.tapAnim.Duration = r.style.AnimationTime
+	}
+	r.background.CornerRadius = r.style.CornerRadius
 	r.label.inset = fyne.NewSize(theme.Padding()*2, theme.Padding()*2)
 	r.label.Segments[0].(*TextSegment).Text = r.cmButton.Text
+	r.label.Segments[0].(*TextSegment).Style = r.style.TextStyle
+	r.label.Segments[0].(*TextSegment).Style.Alignment = fyne.TextAlignCenter
 	r.updateIconAndText()
 	r.applyTheme()
 	r.background.Refresh()
@@ -276,7 +410,7 @@ func (r *cmButtonRenderer) Refresh() {
 	canvas.Refresh(r.cmButton.super())
 }
 
-// applyTheme updates this button to match the current theme
+// applyTheme updates this button to match its resolved ButtonStyle
 func (r *cmButtonRenderer) applyTheme() {
 	r.background.FillColor = r.buttonColor()
 	r.label.Segments[0].(*TextSegment).Style.ColorName = theme.ColorNameForeground
@@ -307,28 +441,21 @@ func (r *cmButtonRenderer) applyTheme() {
 func (r *cmButtonRenderer) buttonColor() color.Color {
 	switch {
 	case r.cmButton.Disabled():
-		return r.cmButton.ColorDisabled
+		return r.style.ColorDisabled
 	case r.cmButton.focused:
-		return blendColor(r.cmButton.ColorEnabled, r.cmButton.ColorFocused)
+		return blendColor(r.style.ColorEnabled, r.style.ColorFocused)
 	case r.cmButton.hovered:
-		bg := r.cmButton.ColorEnabled
+		bg := r.style.ColorEnabled
 		if r.cmButton.Importance == HighImportance {
-			bg = r.cmButton.ColorPrimary
+			bg = r.style.ColorPrimary
 		}
 
-		return blendColor(bg, r.cmButton.ColorHover)
+		return blendColor(bg, r.style.ColorHover)
 	case r.cmButton.Importance == HighImportance:
-		return r.cmButton.ColorPrimary
+		return r.style.ColorPrimary
 	default:
-		return r.cmButton.ColorEnabled
-	}
-}
-
-func (r *cmButtonRenderer) padding() fyne.Size {
-	if r.cmButton.Text == "" {
-		return fyne.NewSize(theme.Padding()*4, theme.Padding()*4)
+		return r.style.ColorEnabled
 	}
-	return fyne.NewSize(theme.Padding()*6, theme.Padding()*4)
 }
 
 func (r *cmButtonRenderer) updateIconAndText() {